@@ -1,3 +1,5 @@
+package leetcode
+
 func twoSum(nums []int, target int) []int {
     numIndices := make(map[int]int)
     for i, num := range nums {
@@ -8,4 +10,55 @@ func twoSum(nums []int, target int) []int {
         numIndices[num] = i
     }
     return []int{}
+}
+
+// twoSumAllPairs returns every unique pair of values summing to target,
+// each as [smaller, larger]. For sorted nums, twoSumSorted finds the same
+// pairs but reports them as index pairs.
+func twoSumAllPairs(nums []int, target int) [][]int {
+    seen := make(map[int]int)
+    used := make(map[[2]int]bool)
+    pairs := [][]int{}
+    for _, num := range nums {
+        complement := target - num
+        if _, ok := seen[complement]; ok {
+            pair := [2]int{num, complement}
+            if complement < num {
+                pair = [2]int{complement, num}
+            }
+            if !used[pair] {
+                used[pair] = true
+                pairs = append(pairs, []int{pair[0], pair[1]})
+            }
+        }
+        seen[num]++
+    }
+    return pairs
+}
+
+// twoSumSorted is the O(n) two-pointer variant for sorted input. It returns
+// the indices [l, r] of one occurrence of each unique value pair.
+func twoSumSorted(nums []int, target int) [][]int {
+    pairs := [][]int{}
+    l, r := 0, len(nums)-1
+    for l < r {
+        sum := nums[l] + nums[r]
+        switch {
+        case sum == target:
+            pairs = append(pairs, []int{l, r})
+            l++
+            r--
+            for l < r && nums[l] == nums[l-1] {
+                l++
+            }
+            for l < r && nums[r] == nums[r+1] {
+                r--
+            }
+        case sum < target:
+            l++
+        default:
+            r--
+        }
+    }
+    return pairs
 }
\ No newline at end of file
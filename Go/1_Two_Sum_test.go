@@ -0,0 +1,92 @@
+package leetcode
+
+import (
+    "reflect"
+    "testing"
+)
+
+func TestTwoSumAllPairs(t *testing.T) {
+    tests := []struct {
+        name   string
+        nums   []int
+        target int
+        want   [][]int
+    }{
+        {
+            name:   "no pairs",
+            nums:   []int{1, 2, 3},
+            target: 100,
+            want:   [][]int{},
+        },
+        {
+            name:   "single pair",
+            nums:   []int{2, 7, 11, 15},
+            target: 9,
+            want:   [][]int{{2, 7}},
+        },
+        {
+            name:   "duplicate values collapse to one pair",
+            nums:   []int{3, 3, 3, 3},
+            target: 6,
+            want:   [][]int{{3, 3}},
+        },
+        {
+            name:   "multiple distinct pairs",
+            nums:   []int{1, 5, 5, 2, 3, 4},
+            target: 6,
+            want:   [][]int{{1, 5}, {2, 4}},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := twoSumAllPairs(tt.nums, tt.target)
+            if !reflect.DeepEqual(got, tt.want) {
+                t.Errorf("twoSumAllPairs(%v, %d) = %v, want %v", tt.nums, tt.target, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestTwoSumSorted(t *testing.T) {
+    tests := []struct {
+        name   string
+        nums   []int
+        target int
+        want   [][]int
+    }{
+        {
+            name:   "no pairs",
+            nums:   []int{1, 2, 3},
+            target: 100,
+            want:   [][]int{},
+        },
+        {
+            name:   "single pair",
+            nums:   []int{2, 7, 11, 15},
+            target: 9,
+            want:   [][]int{{0, 1}},
+        },
+        {
+            name:   "duplicate values collapse to one pair",
+            nums:   []int{3, 3, 3, 3},
+            target: 6,
+            want:   [][]int{{0, 3}},
+        },
+        {
+            name:   "multiple distinct pairs",
+            nums:   []int{1, 2, 3, 4, 5},
+            target: 6,
+            want:   [][]int{{0, 4}, {1, 3}},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := twoSumSorted(tt.nums, tt.target)
+            if !reflect.DeepEqual(got, tt.want) {
+                t.Errorf("twoSumSorted(%v, %d) = %v, want %v", tt.nums, tt.target, got, tt.want)
+            }
+        })
+    }
+}
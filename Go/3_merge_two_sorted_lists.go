@@ -1,10 +1,12 @@
-/**
- * Definition for singly-linked list.
- * type ListNode struct {
- *     Val int
- *     Next *ListNode
- * }
- */
+package leetcode
+
+// ListNode is the definition for singly-linked list used throughout
+// the linked-list solutions in this package.
+type ListNode struct {
+    Val  int
+    Next *ListNode
+}
+
 func mergeTwoLists(list1 *ListNode, list2 *ListNode) *ListNode {
     if list1 == nil {
         return list2
@@ -38,3 +40,100 @@ func mergeTwoLists(list1 *ListNode, list2 *ListNode) *ListNode {
     }
     return mergedHead
 }
+
+// LeetCode 23: Merge k Sorted Lists
+func mergeKLists(lists []*ListNode) *ListNode {
+    if len(lists) == 0 {
+        return nil
+    }
+    return mergeKListsRange(lists, 0, len(lists)-1)
+}
+
+func mergeKListsRange(lists []*ListNode, left int, right int) *ListNode {
+    if left == right {
+        return lists[left]
+    }
+    mid := left + (right-left)/2
+    l1 := mergeKListsRange(lists, left, mid)
+    l2 := mergeKListsRange(lists, mid+1, right)
+    return mergeTwoLists(l1, l2)
+}
+
+// LeetCode 2: Add Two Numbers
+func addTwoNumbers(l1 *ListNode, l2 *ListNode) *ListNode {
+    dummy := &ListNode{}
+    current := dummy
+    carry := 0
+    for l1 != nil || l2 != nil || carry != 0 {
+        sum := carry
+        if l1 != nil {
+            sum += l1.Val
+            l1 = l1.Next
+        }
+        if l2 != nil {
+            sum += l2.Val
+            l2 = l2.Next
+        }
+        carry = sum / 10
+        current.Next = &ListNode{Val: sum % 10}
+        current = current.Next
+    }
+    return dummy.Next
+}
+
+// LeetCode 160: Intersection of Two Linked Lists
+func getIntersectionNode(headA *ListNode, headB *ListNode) *ListNode {
+    a, b := headA, headB
+    for a != b {
+        if a == nil {
+            a = headB
+        } else {
+            a = a.Next
+        }
+        if b == nil {
+            b = headA
+        } else {
+            b = b.Next
+        }
+    }
+    return a
+}
+
+// LeetCode 148: Sort List
+func sortList(head *ListNode) *ListNode {
+    if head == nil || head.Next == nil {
+        return head
+    }
+    n := 0
+    for node := head; node != nil; node = node.Next {
+        n++
+    }
+    dummy := &ListNode{Next: head}
+    for size := 1; size < n; size *= 2 {
+        prev := dummy
+        current := dummy.Next
+        for current != nil {
+            left := current
+            right := split(left, size)
+            current = split(right, size)
+            merged := mergeTwoLists(left, right)
+            prev.Next = merged
+            for prev.Next != nil {
+                prev = prev.Next
+            }
+        }
+    }
+    return dummy.Next
+}
+
+func split(head *ListNode, size int) *ListNode {
+    for i := 1; head != nil && i < size; i++ {
+        head = head.Next
+    }
+    if head == nil {
+        return nil
+    }
+    rest := head.Next
+    head.Next = nil
+    return rest
+}
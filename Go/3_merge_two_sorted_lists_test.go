@@ -0,0 +1,194 @@
+package leetcode
+
+import (
+    "reflect"
+    "testing"
+)
+
+func sliceToList(vals []int) *ListNode {
+    dummy := &ListNode{}
+    current := dummy
+    for _, v := range vals {
+        current.Next = &ListNode{Val: v}
+        current = current.Next
+    }
+    return dummy.Next
+}
+
+func listToSlice(head *ListNode) []int {
+    vals := []int{}
+    for node := head; node != nil; node = node.Next {
+        vals = append(vals, node.Val)
+    }
+    return vals
+}
+
+func TestMergeKLists(t *testing.T) {
+    tests := []struct {
+        name  string
+        lists [][]int
+        want  []int
+    }{
+        {
+            name:  "empty input",
+            lists: [][]int{},
+            want:  []int{},
+        },
+        {
+            name:  "singleton list",
+            lists: [][]int{{1, 3, 5}},
+            want:  []int{1, 3, 5},
+        },
+        {
+            name:  "multiple sorted lists",
+            lists: [][]int{{1, 4, 5}, {1, 3, 4}, {2, 6}},
+            want:  []int{1, 1, 2, 3, 4, 4, 5, 6},
+        },
+        {
+            name:  "some empty sublists",
+            lists: [][]int{{}, {2}, {}},
+            want:  []int{2},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            nodes := make([]*ListNode, len(tt.lists))
+            for i, l := range tt.lists {
+                nodes[i] = sliceToList(l)
+            }
+            got := listToSlice(mergeKLists(nodes))
+            if !reflect.DeepEqual(got, tt.want) {
+                t.Errorf("mergeKLists(%v) = %v, want %v", tt.lists, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestGetIntersectionNode(t *testing.T) {
+    t.Run("no intersection", func(t *testing.T) {
+        headA := sliceToList([]int{1, 2, 3})
+        headB := sliceToList([]int{4, 5})
+        if got := getIntersectionNode(headA, headB); got != nil {
+            t.Errorf("getIntersectionNode() = %v, want nil", got)
+        }
+    })
+
+    t.Run("intersecting lists of equal length", func(t *testing.T) {
+        intersection := sliceToList([]int{8, 9})
+        headA := sliceToList([]int{1, 2})
+        headB := sliceToList([]int{3, 4})
+        attach(headA, intersection)
+        attach(headB, intersection)
+
+        if got := getIntersectionNode(headA, headB); got != intersection {
+            t.Errorf("getIntersectionNode() = %v, want %v", got, intersection)
+        }
+    })
+
+    t.Run("intersecting lists of unequal length", func(t *testing.T) {
+        intersection := sliceToList([]int{8, 9})
+        headA := sliceToList([]int{1, 2, 3})
+        headB := sliceToList([]int{4})
+        attach(headA, intersection)
+        attach(headB, intersection)
+
+        if got := getIntersectionNode(headA, headB); got != intersection {
+            t.Errorf("getIntersectionNode() = %v, want %v", got, intersection)
+        }
+    })
+}
+
+// attach appends tail to the end of head and returns head, or tail when
+// head is nil.
+func attach(head *ListNode, tail *ListNode) *ListNode {
+    if head == nil {
+        return tail
+    }
+    node := head
+    for node.Next != nil {
+        node = node.Next
+    }
+    node.Next = tail
+    return head
+}
+
+func TestSortList(t *testing.T) {
+    tests := []struct {
+        name  string
+        input []int
+        want  []int
+    }{
+        {
+            name:  "empty list",
+            input: []int{},
+            want:  []int{},
+        },
+        {
+            name:  "singleton list",
+            input: []int{1},
+            want:  []int{1},
+        },
+        {
+            name:  "already sorted",
+            input: []int{1, 2, 3, 4},
+            want:  []int{1, 2, 3, 4},
+        },
+        {
+            name:  "reverse sorted",
+            input: []int{4, 3, 2, 1},
+            want:  []int{1, 2, 3, 4},
+        },
+        {
+            name:  "odd length with duplicates",
+            input: []int{5, -1, 5, 0, 3},
+            want:  []int{-1, 0, 3, 5, 5},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := listToSlice(sortList(sliceToList(tt.input)))
+            if !reflect.DeepEqual(got, tt.want) {
+                t.Errorf("sortList(%v) = %v, want %v", tt.input, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestAddTwoNumbers(t *testing.T) {
+    tests := []struct {
+        name string
+        l1   []int
+        l2   []int
+        want []int
+    }{
+        {
+            name: "equal length",
+            l1:   []int{2, 4, 3},
+            l2:   []int{5, 6, 4},
+            want: []int{7, 0, 8},
+        },
+        {
+            name: "unequal length",
+            l1:   []int{9, 9},
+            l2:   []int{1},
+            want: []int{0, 0, 1},
+        },
+        {
+            name: "carry overflow",
+            l1:   []int{9, 9, 9, 9},
+            l2:   []int{9, 9, 9},
+            want: []int{8, 9, 9, 0, 1},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := listToSlice(addTwoNumbers(sliceToList(tt.l1), sliceToList(tt.l2)))
+            if !reflect.DeepEqual(got, tt.want) {
+                t.Errorf("addTwoNumbers(%v, %v) = %v, want %v", tt.l1, tt.l2, got, tt.want)
+            }
+        })
+    }
+}